@@ -0,0 +1,192 @@
+// ABOUTME: Build manifest recording the checksum and provenance of an installed gt binary.
+// ABOUTME: Shared by `gt update` (which writes it) and `gt verify` (which checks it).
+
+package cmd
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// BuildManifest records everything needed to verify the provenance of an
+// installed gt binary. It composes with versionInfo but is persisted
+// alongside the binary so it survives process restarts.
+type BuildManifest struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+	GOOS      string `json:"goos"`
+	GOARCH    string `json:"goarch"`
+	SHA256    string `json:"sha256"`
+}
+
+// manifestPath returns the sidecar manifest path for an installed binary.
+func manifestPath(installPath string) string {
+	return filepath.Join(filepath.Dir(installPath), "gt.sha256")
+}
+
+// signaturePath returns the detached signature path for a manifest.
+func signaturePath(manifestFile string) string {
+	return manifestFile + ".sig"
+}
+
+// sha256File returns the lowercase hex SHA256 digest of the file at path.
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// buildManifest computes the manifest for a freshly built binary.
+func buildManifest(binaryPath string, info *versionInfo) (*BuildManifest, error) {
+	sum, err := sha256File(binaryPath)
+	if err != nil {
+		return nil, fmt.Errorf("hashing binary: %w", err)
+	}
+
+	return &BuildManifest{
+		Version:   info.Version,
+		Commit:    info.Commit,
+		BuildTime: info.BuildTime,
+		GoVersion: runtime.Version(),
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		SHA256:    sum,
+	}, nil
+}
+
+// writeManifest marshals and writes the manifest next to installPath.
+func writeManifest(installPath string, m *BuildManifest) (string, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling manifest: %w", err)
+	}
+
+	path := manifestPath(installPath)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("writing manifest: %w", err)
+	}
+	return path, nil
+}
+
+// loadManifest reads and parses the manifest for an installed binary.
+func loadManifest(installPath string) (*BuildManifest, error) {
+	data, err := os.ReadFile(manifestPath(installPath))
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+
+	var m BuildManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// signManifest signs the manifest file with the ed25519 private key stored at
+// keyPath (a raw 64-byte ed25519 private key, not a 32-byte seed, hex- or
+// base64-encoded) and writes a detached
+// signature alongside it.
+func signManifest(manifestFile, keyPath string) (string, error) {
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		return "", fmt.Errorf("reading sign key: %w", err)
+	}
+
+	priv, err := decodeEd25519PrivateKey(keyData)
+	if err != nil {
+		return "", fmt.Errorf("decoding sign key: %w", err)
+	}
+
+	manifestData, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return "", fmt.Errorf("reading manifest: %w", err)
+	}
+
+	sig := ed25519.Sign(priv, manifestData)
+	sigPath := signaturePath(manifestFile)
+	if err := os.WriteFile(sigPath, []byte(base64.StdEncoding.EncodeToString(sig)), 0644); err != nil {
+		return "", fmt.Errorf("writing signature: %w", err)
+	}
+	return sigPath, nil
+}
+
+// verifyManifestSignature checks the detached signature over manifestFile
+// against pubKey (hex- or base64-encoded ed25519 public key).
+func verifyManifestSignature(manifestFile, pubKey string) error {
+	pub, err := decodeEd25519PublicKey(pubKey)
+	if err != nil {
+		return fmt.Errorf("decoding trusted public key: %w", err)
+	}
+
+	sigData, err := os.ReadFile(signaturePath(manifestFile))
+	if err != nil {
+		return fmt.Errorf("reading signature: %w", err)
+	}
+	sig, err := base64.StdEncoding.DecodeString(string(sigData))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+
+	manifestData, err := os.ReadFile(manifestFile)
+	if err != nil {
+		return fmt.Errorf("reading manifest: %w", err)
+	}
+
+	if !ed25519.Verify(pub, manifestData, sig) {
+		return fmt.Errorf("signature does not match manifest")
+	}
+	return nil
+}
+
+func decodeEd25519PrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	raw, err := decodeKeyBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("expected %d byte ed25519 private key, got %d", ed25519.PrivateKeySize, len(raw))
+	}
+	return ed25519.PrivateKey(raw), nil
+}
+
+func decodeEd25519PublicKey(s string) (ed25519.PublicKey, error) {
+	raw, err := decodeKeyBytes([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("expected %d byte ed25519 public key, got %d", ed25519.PublicKeySize, len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+// decodeKeyBytes accepts hex or base64 encoded key material, trimming whitespace.
+func decodeKeyBytes(data []byte) ([]byte, error) {
+	trimmed := strings.TrimSpace(string(data))
+	if raw, err := hex.DecodeString(trimmed); err == nil {
+		return raw, nil
+	}
+	if raw, err := base64.StdEncoding.DecodeString(trimmed); err == nil {
+		return raw, nil
+	}
+	return nil, fmt.Errorf("key is neither valid hex nor base64")
+}
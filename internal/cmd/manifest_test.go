@@ -0,0 +1,156 @@
+// ABOUTME: Tests for the build manifest checksum and signature verification logic.
+
+package cmd
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyChecksumMatches(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "gt_v1.0.0_linux_amd64.tar.gz")
+	if err := os.WriteFile(archivePath, []byte("fake archive contents"), 0644); err != nil {
+		t.Fatalf("writing archive: %v", err)
+	}
+
+	sum, err := sha256File(archivePath)
+	if err != nil {
+		t.Fatalf("hashing archive: %v", err)
+	}
+
+	checksumsPath := filepath.Join(dir, "checksums.txt")
+	checksums := sum + "  gt_v1.0.0_linux_amd64.tar.gz\n"
+	if err := os.WriteFile(checksumsPath, []byte(checksums), 0644); err != nil {
+		t.Fatalf("writing checksums: %v", err)
+	}
+
+	if err := verifyChecksum(checksumsPath, "gt_v1.0.0_linux_amd64.tar.gz", archivePath); err != nil {
+		t.Fatalf("verifyChecksum returned error for a matching checksum: %v", err)
+	}
+}
+
+func TestVerifyChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "gt_v1.0.0_linux_amd64.tar.gz")
+	if err := os.WriteFile(archivePath, []byte("fake archive contents"), 0644); err != nil {
+		t.Fatalf("writing archive: %v", err)
+	}
+
+	checksumsPath := filepath.Join(dir, "checksums.txt")
+	checksums := "0000000000000000000000000000000000000000000000000000000000000000  gt_v1.0.0_linux_amd64.tar.gz\n"
+	if err := os.WriteFile(checksumsPath, []byte(checksums), 0644); err != nil {
+		t.Fatalf("writing checksums: %v", err)
+	}
+
+	if err := verifyChecksum(checksumsPath, "gt_v1.0.0_linux_amd64.tar.gz", archivePath); err == nil {
+		t.Fatal("verifyChecksum returned nil for a mismatched checksum")
+	}
+}
+
+func TestVerifyChecksumMissingEntry(t *testing.T) {
+	dir := t.TempDir()
+	archivePath := filepath.Join(dir, "gt_v1.0.0_linux_amd64.tar.gz")
+	if err := os.WriteFile(archivePath, []byte("fake archive contents"), 0644); err != nil {
+		t.Fatalf("writing archive: %v", err)
+	}
+
+	checksumsPath := filepath.Join(dir, "checksums.txt")
+	if err := os.WriteFile(checksumsPath, []byte("deadbeef  some_other_asset.tar.gz\n"), 0644); err != nil {
+		t.Fatalf("writing checksums: %v", err)
+	}
+
+	if err := verifyChecksum(checksumsPath, "gt_v1.0.0_linux_amd64.tar.gz", archivePath); err == nil {
+		t.Fatal("verifyChecksum returned nil when the asset has no checksum entry")
+	}
+}
+
+func TestVerifyManifestSignatureGoodKey(t *testing.T) {
+	dir := t.TempDir()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	manifestFile := filepath.Join(dir, "gt.sha256")
+	if err := os.WriteFile(manifestFile, []byte(`{"version":"v1.0.0"}`), 0644); err != nil {
+		t.Fatalf("writing manifest: %v", err)
+	}
+
+	keyPath := filepath.Join(dir, "sign.key")
+	if err := os.WriteFile(keyPath, []byte(hex.EncodeToString(priv)), 0600); err != nil {
+		t.Fatalf("writing sign key: %v", err)
+	}
+
+	if _, err := signManifest(manifestFile, keyPath); err != nil {
+		t.Fatalf("signManifest: %v", err)
+	}
+
+	if err := verifyManifestSignature(manifestFile, hex.EncodeToString(pub)); err != nil {
+		t.Fatalf("verifyManifestSignature rejected a valid signature: %v", err)
+	}
+}
+
+func TestVerifyManifestSignatureWrongKey(t *testing.T) {
+	dir := t.TempDir()
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating other key: %v", err)
+	}
+
+	manifestFile := filepath.Join(dir, "gt.sha256")
+	if err := os.WriteFile(manifestFile, []byte(`{"version":"v1.0.0"}`), 0644); err != nil {
+		t.Fatalf("writing manifest: %v", err)
+	}
+
+	keyPath := filepath.Join(dir, "sign.key")
+	if err := os.WriteFile(keyPath, []byte(hex.EncodeToString(priv)), 0600); err != nil {
+		t.Fatalf("writing sign key: %v", err)
+	}
+
+	if _, err := signManifest(manifestFile, keyPath); err != nil {
+		t.Fatalf("signManifest: %v", err)
+	}
+
+	if err := verifyManifestSignature(manifestFile, hex.EncodeToString(otherPub)); err == nil {
+		t.Fatal("verifyManifestSignature accepted a signature under the wrong public key")
+	}
+}
+
+func TestVerifyManifestSignatureTamperedManifest(t *testing.T) {
+	dir := t.TempDir()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+
+	manifestFile := filepath.Join(dir, "gt.sha256")
+	if err := os.WriteFile(manifestFile, []byte(`{"version":"v1.0.0"}`), 0644); err != nil {
+		t.Fatalf("writing manifest: %v", err)
+	}
+
+	keyPath := filepath.Join(dir, "sign.key")
+	if err := os.WriteFile(keyPath, []byte(hex.EncodeToString(priv)), 0600); err != nil {
+		t.Fatalf("writing sign key: %v", err)
+	}
+
+	if _, err := signManifest(manifestFile, keyPath); err != nil {
+		t.Fatalf("signManifest: %v", err)
+	}
+
+	// Tamper with the manifest after signing, without touching the signature.
+	if err := os.WriteFile(manifestFile, []byte(`{"version":"v1.0.0-tampered"}`), 0644); err != nil {
+		t.Fatalf("tampering with manifest: %v", err)
+	}
+
+	if err := verifyManifestSignature(manifestFile, hex.EncodeToString(pub)); err == nil {
+		t.Fatal("verifyManifestSignature accepted a signature over a tampered manifest")
+	}
+}
@@ -0,0 +1,332 @@
+// ABOUTME: Command to cross-compile gt for multiple platforms and package release archives.
+// ABOUTME: Sibling of `gt update` for producing distributable builds rather than installing locally.
+
+package cmd
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/version"
+)
+
+// BuildTarget describes a single GOOS/GOARCH combination to cross-compile gt for.
+type BuildTarget struct {
+	GOOS          string
+	GOARCH        string
+	BinaryName    string
+	ArchiveFormat string // "tar.gz" or "zip"
+	ExtraTags     string
+	LDFlags       string
+}
+
+// String returns the canonical "goos/goarch" identifier for the target.
+func (t BuildTarget) String() string {
+	return t.GOOS + "/" + t.GOARCH
+}
+
+// binaryFileName returns the binary's file name, with ".exe" appended on windows.
+func (t BuildTarget) binaryFileName() string {
+	name := t.BinaryName
+	if t.GOOS == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// defaultTargets is the registry of platforms `gt release --all-targets` builds for.
+var defaultTargets = []BuildTarget{
+	{GOOS: "linux", GOARCH: "amd64", BinaryName: "gt", ArchiveFormat: "tar.gz"},
+	{GOOS: "linux", GOARCH: "arm64", BinaryName: "gt", ArchiveFormat: "tar.gz"},
+	{GOOS: "darwin", GOARCH: "amd64", BinaryName: "gt", ArchiveFormat: "tar.gz"},
+	{GOOS: "darwin", GOARCH: "arm64", BinaryName: "gt", ArchiveFormat: "tar.gz"},
+	{GOOS: "windows", GOARCH: "amd64", BinaryName: "gt", ArchiveFormat: "zip"},
+}
+
+var (
+	releaseTargets   []string
+	releaseAll       bool
+	releaseOutputDir string
+)
+
+var releaseCmd = &cobra.Command{
+	Use:     "release",
+	GroupID: GroupConfig,
+	Short:   "Cross-compile gt for multiple platforms and package release archives",
+	Long: `Cross-compile gt for one or more GOOS/GOARCH targets and package each
+build into a release archive alongside LICENSE and README.
+
+Examples:
+  gt release --target=linux/arm64,darwin/arm64
+  gt release --all-targets --output-dir=dist/
+  gt release --target=windows/amd64`,
+	RunE: runRelease,
+}
+
+func init() {
+	releaseCmd.Flags().StringSliceVar(&releaseTargets, "target", nil, "GOOS/GOARCH pairs to build, e.g. linux/arm64,darwin/arm64")
+	releaseCmd.Flags().BoolVar(&releaseAll, "all-targets", false, "Build every target in the default registry")
+	releaseCmd.Flags().StringVar(&releaseOutputDir, "output-dir", "dist", "Directory to write release archives to")
+	rootCmd.AddCommand(releaseCmd)
+}
+
+func runRelease(cmd *cobra.Command, args []string) error {
+	repoRoot, err := version.GetRepoRoot()
+	if err != nil {
+		return fmt.Errorf("cannot locate gt source repository: %w\n\nMake sure you have the gastown repository cloned and set GT_ROOT if needed", err)
+	}
+
+	targets, err := resolveReleaseTargets()
+	if err != nil {
+		return err
+	}
+
+	info, err := getVersionInfo(repoRoot)
+	if err != nil {
+		return fmt.Errorf("getting version info: %w", err)
+	}
+
+	if err := os.MkdirAll(releaseOutputDir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	fmt.Printf("%s Building %d target(s) for gt %s\n\n", style.Bold.Render("📦"), len(targets), info.Version)
+
+	for _, target := range targets {
+		if err := buildReleaseTarget(repoRoot, target, info); err != nil {
+			return fmt.Errorf("building %s: %w", target.String(), err)
+		}
+	}
+
+	fmt.Printf("\n%s Release archives written to %s\n", style.Success.Render("✓"), releaseOutputDir)
+	return nil
+}
+
+// resolveReleaseTargets turns --target/--all-targets flags into a concrete target list.
+func resolveReleaseTargets() ([]BuildTarget, error) {
+	if releaseAll {
+		return defaultTargets, nil
+	}
+	if len(releaseTargets) == 0 {
+		return nil, fmt.Errorf("no targets specified; use --target=goos/goarch or --all-targets")
+	}
+
+	var targets []BuildTarget
+	for _, spec := range releaseTargets {
+		parts := strings.SplitN(spec, "/", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid target %q; expected GOOS/GOARCH", spec)
+		}
+		goos, goarch := parts[0], parts[1]
+
+		target, ok := lookupDefaultTarget(goos, goarch)
+		if !ok {
+			target = BuildTarget{GOOS: goos, GOARCH: goarch, BinaryName: "gt", ArchiveFormat: archiveFormatFor(goos)}
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func lookupDefaultTarget(goos, goarch string) (BuildTarget, bool) {
+	for _, t := range defaultTargets {
+		if t.GOOS == goos && t.GOARCH == goarch {
+			return t, true
+		}
+	}
+	return BuildTarget{}, false
+}
+
+func archiveFormatFor(goos string) string {
+	if goos == "windows" {
+		return "zip"
+	}
+	return "tar.gz"
+}
+
+// buildReleaseTarget cross-compiles gt for a single target and packages the result.
+func buildReleaseTarget(repoRoot string, target BuildTarget, info *versionInfo) error {
+	fmt.Printf("  • %s...\n", target.String())
+
+	tmpDir, err := os.MkdirTemp("", "gt-release-")
+	if err != nil {
+		return fmt.Errorf("creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	binaryPath := filepath.Join(tmpDir, target.binaryFileName())
+
+	targetEnv := append(os.Environ(),
+		"GOOS="+target.GOOS,
+		"GOARCH="+target.GOARCH,
+	)
+
+	// Run go generate before building, same as `gt update` - otherwise a
+	// release target can ship stale or missing go:embed assets.
+	genCmd := exec.Command("go", "generate", "./...")
+	genCmd.Dir = repoRoot
+	genCmd.Env = targetEnv
+	genCmd.Stderr = os.Stderr
+	if err := genCmd.Run(); err != nil {
+		return fmt.Errorf("go generate failed: %w", err)
+	}
+
+	ldflags := fmt.Sprintf("-X github.com/steveyegge/gastown/internal/cmd.Version=%s -X github.com/steveyegge/gastown/internal/cmd.Commit=%s -X github.com/steveyegge/gastown/internal/cmd.BuildTime=%s",
+		info.Version, info.Commit, info.BuildTime)
+	if target.LDFlags != "" {
+		ldflags += " " + target.LDFlags
+	}
+
+	buildArgs := []string{"build", "-ldflags", ldflags}
+	if target.ExtraTags != "" {
+		buildArgs = append(buildArgs, "-tags", target.ExtraTags)
+	}
+	buildArgs = append(buildArgs, "-o", binaryPath, "./cmd/gt")
+
+	buildCmd := exec.Command("go", buildArgs...)
+	buildCmd.Dir = repoRoot
+	buildCmd.Stderr = os.Stderr
+	buildCmd.Env = targetEnv
+	if err := buildCmd.Run(); err != nil {
+		return fmt.Errorf("go build failed: %w", err)
+	}
+
+	// Codesigning only applies to a darwin binary built on a darwin host.
+	if target.GOOS == "darwin" && runtime.GOOS == "darwin" {
+		signCmd := exec.Command("codesign", "-s", "-", "-f", binaryPath)
+		_ = signCmd.Run()
+	}
+
+	archivePath, err := packageRelease(repoRoot, tmpDir, target, info)
+	if err != nil {
+		return fmt.Errorf("packaging archive: %w", err)
+	}
+
+	fmt.Printf("    %s\n", style.Dim.Render(archivePath))
+	return nil
+}
+
+// packageRelease bundles the built binary with LICENSE/README into a release archive.
+func packageRelease(repoRoot, buildDir string, target BuildTarget, info *versionInfo) (string, error) {
+	archiveName := fmt.Sprintf("gt_%s_%s_%s", info.Version, target.GOOS, target.GOARCH)
+	binaryPath := filepath.Join(buildDir, target.binaryFileName())
+
+	extras := []string{"LICENSE", "README.md"}
+	var files []string
+	files = append(files, binaryPath)
+	for _, extra := range extras {
+		p := filepath.Join(repoRoot, extra)
+		if _, err := os.Stat(p); err == nil {
+			files = append(files, p)
+		}
+	}
+
+	switch target.ArchiveFormat {
+	case "zip":
+		archivePath := filepath.Join(releaseOutputDir, archiveName+".zip")
+		return archivePath, writeZipArchive(archivePath, files)
+	default:
+		archivePath := filepath.Join(releaseOutputDir, archiveName+".tar.gz")
+		return archivePath, writeTarGzArchive(archivePath, files)
+	}
+}
+
+// writeTarGzArchive writes files into a tar.gz at archivePath. The tar, gzip,
+// and file writers are closed explicitly, in order, so a failed final flush
+// (e.g. disk full) surfaces as an error instead of silently producing a
+// truncated archive that still reports success.
+func writeTarGzArchive(archivePath string, files []string) error {
+	out, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	gzw := gzip.NewWriter(out)
+	tw := tar.NewWriter(gzw)
+
+	for _, f := range files {
+		if err := addFileToTar(tw, f); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("closing tar writer: %w", err)
+	}
+	if err := gzw.Close(); err != nil {
+		return fmt.Errorf("closing gzip writer: %w", err)
+	}
+	return out.Close()
+}
+
+func addFileToTar(tw *tar.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	hdr := &tar.Header{
+		Name: filepath.Base(path),
+		Mode: int64(info.Mode().Perm()),
+		Size: int64(len(data)),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+// writeZipArchive writes files into a zip at archivePath, closing the zip
+// and file writers explicitly so a failed final flush is reported rather
+// than producing a silently truncated archive.
+func writeZipArchive(archivePath string, files []string) error {
+	out, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+
+	for _, f := range files {
+		if err := addFileToZip(zw, f); err != nil {
+			return err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("closing zip writer: %w", err)
+	}
+	return out.Close()
+}
+
+func addFileToZip(zw *zip.Writer, path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	w, err := zw.Create(filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, src)
+	return err
+}
@@ -0,0 +1,156 @@
+// ABOUTME: Isolated build sandbox for `gt update`, borrowed from restic/build.go's temp-GOPATH technique.
+// ABOUTME: Materializes only tracked source files into a scratch module tree so builds can't see local drift.
+
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// buildSandbox is a scratch directory holding a copy of the tracked source
+// tree plus an isolated GOPATH/module cache, so `go build` can't pick up
+// local replace directives, dirty workspace state, or env drift.
+type buildSandbox struct {
+	Dir        string // sandbox root, containing the copied module
+	GOPATH     string
+	GOCACHE    string
+	GOMODCACHE string
+}
+
+// newBuildSandbox materializes a sandbox under os.TempDir by copying every
+// git-tracked file from repoRoot (see sandboxSourceFiles), preserving
+// relative paths.
+func newBuildSandbox(repoRoot string) (*buildSandbox, error) {
+	dir, err := os.MkdirTemp(os.TempDir(), "gt-build-sandbox-")
+	if err != nil {
+		return nil, fmt.Errorf("creating sandbox dir: %w", err)
+	}
+
+	files, err := sandboxSourceFiles(repoRoot)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("listing tracked files: %w", err)
+	}
+
+	for _, rel := range files {
+		if err := copySandboxFile(repoRoot, dir, rel); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("copying %s: %w", rel, err)
+		}
+	}
+
+	gopath := filepath.Join(dir, ".gopath")
+	sb := &buildSandbox{
+		Dir:        dir,
+		GOPATH:     gopath,
+		GOCACHE:    filepath.Join(dir, ".gocache"),
+		GOMODCACHE: filepath.Join(gopath, "pkg", "mod"),
+	}
+	for _, d := range []string{sb.GOPATH, sb.GOCACHE, sb.GOMODCACHE} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			os.RemoveAll(dir)
+			return nil, fmt.Errorf("creating %s: %w", d, err)
+		}
+	}
+
+	return sb, nil
+}
+
+// Close removes the sandbox directory.
+func (sb *buildSandbox) Close() error {
+	// Go writes the module cache read-only; make its directories writable
+	// first so RemoveAll can delete their contents.
+	_ = filepath.WalkDir(sb.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && d.IsDir() {
+			_ = os.Chmod(path, 0755)
+		}
+		return nil
+	})
+	return os.RemoveAll(sb.Dir)
+}
+
+// Env returns the environment a build command run inside the sandbox should
+// use. epoch is ignored unless reproducible is set; see sourceDateEpoch.
+func (sb *buildSandbox) Env(reproducible bool, epoch int64) []string {
+	env := append(os.Environ(),
+		"GOPATH="+sb.GOPATH,
+		"GOMODCACHE="+sb.GOMODCACHE,
+		"GOCACHE="+sb.GOCACHE,
+		"GOFLAGS=-trimpath -mod=readonly",
+	)
+	if reproducible {
+		env = append(env, fmt.Sprintf("SOURCE_DATE_EPOCH=%d", epoch))
+	}
+	return env
+}
+
+// sourceDateEpoch pins a reproducible build timestamp: an externally
+// provided SOURCE_DATE_EPOCH wins if set, otherwise it's derived from
+// repoRoot's HEAD commit time, so the same commit always yields the same
+// epoch. It deliberately never falls back to time.Now() - that would make
+// --reproducible produce a different binary on every run, defeating the flag.
+func sourceDateEpoch(repoRoot string) (int64, error) {
+	if v := os.Getenv("SOURCE_DATE_EPOCH"); v != "" {
+		var epoch int64
+		if _, err := fmt.Sscanf(v, "%d", &epoch); err != nil {
+			return 0, fmt.Errorf("parsing SOURCE_DATE_EPOCH=%q: %w", v, err)
+		}
+		return epoch, nil
+	}
+
+	commitTimeCmd := exec.Command("git", "show", "-s", "--format=%ct", "HEAD")
+	commitTimeCmd.Dir = repoRoot
+	out, err := commitTimeCmd.Output()
+	if err != nil {
+		return 0, fmt.Errorf("getting HEAD commit time: %w", err)
+	}
+
+	var epoch int64
+	if _, err := fmt.Sscanf(strings.TrimSpace(string(out)), "%d", &epoch); err != nil {
+		return 0, fmt.Errorf("parsing commit time %q: %w", out, err)
+	}
+	return epoch, nil
+}
+
+// sandboxSourceFiles returns every git-tracked file in repoRoot. Tracked
+// files are already clean by definition, and go:embed targets can live
+// anywhere in the tree (templates, testdata-style assets, arbitrary
+// extensions) - filtering by extension or directory name risks silently
+// shipping a sandbox that can't satisfy a go:embed pattern, so we just copy
+// everything git knows about.
+func sandboxSourceFiles(repoRoot string) ([]string, error) {
+	lsCmd := exec.Command("git", "ls-files")
+	lsCmd.Dir = repoRoot
+	out, err := lsCmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		files = append(files, line)
+	}
+	return files, nil
+}
+
+func copySandboxFile(srcRoot, dstRoot, rel string) error {
+	data, err := os.ReadFile(filepath.Join(srcRoot, rel))
+	if err != nil {
+		return err
+	}
+
+	dst := filepath.Join(dstRoot, rel)
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, 0644)
+}
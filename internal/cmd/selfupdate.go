@@ -0,0 +1,431 @@
+// ABOUTME: Self-update path for gt that downloads a prebuilt binary from GitHub Releases.
+// ABOUTME: Used by `gt update --from-release` or automatically when no source repo can be found.
+
+package cmd
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// runUpdateFromRelease installs a prebuilt gt binary from GitHub Releases.
+// It's used when --from-release is passed, or automatically when no gt
+// source repository can be found, so prebuilt installs still get `gt update`.
+func runUpdateFromRelease() error {
+	fmt.Printf("%s Checking GitHub releases for steveyegge/gastown...\n\n", style.Bold.Render("🔍"))
+
+	release, err := fetchRelease(updateVersion)
+	if err != nil {
+		return fmt.Errorf("fetching release: %w", err)
+	}
+
+	if Version != "" && Version != "dev" {
+		if Version == release.TagName {
+			fmt.Printf("%s Already on the latest release (%s)\n", style.Success.Render("✓"), release.TagName)
+			if !updateForce {
+				fmt.Println("\nUse --force to reinstall anyway")
+				return nil
+			}
+		} else {
+			fmt.Printf("%s Installed release %s is behind upstream release %s\n\n", style.Warning.Render("⚠"), Version, release.TagName)
+		}
+	}
+
+	asset, err := findReleaseAsset(release)
+	if err != nil {
+		return err
+	}
+
+	installPath, err := determineInstallLocation()
+	if err != nil {
+		return fmt.Errorf("determining install location: %w", err)
+	}
+
+	fmt.Printf("Release:  %s\n", release.TagName)
+	fmt.Printf("Asset:    %s (%s)\n", asset.Name, humanBytes(asset.Size))
+	fmt.Printf("Target:   %s\n\n", installPath)
+
+	if updateDryRun {
+		fmt.Printf("%s Dry run mode - no changes will be made\n", style.Dim.Render("ℹ"))
+		return nil
+	}
+
+	if !updateForce {
+		fmt.Print("Continue with install? [Y/n] ")
+		var response string
+		fmt.Scanln(&response)
+		response = strings.ToLower(strings.TrimSpace(response))
+		if response == "n" || response == "no" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gt-release-update-")
+	if err != nil {
+		return fmt.Errorf("creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	archivePath := filepath.Join(tmpDir, asset.Name)
+	fmt.Printf("  • Downloading %s...\n", asset.Name)
+	if err := downloadWithProgress(asset.BrowserDownloadURL, archivePath); err != nil {
+		return fmt.Errorf("downloading release asset: %w", err)
+	}
+
+	if checksums := findChecksumsAsset(release); checksums != nil {
+		checksumsPath := filepath.Join(tmpDir, "checksums.txt")
+		fmt.Printf("  • Downloading checksums.txt...\n")
+		if err := downloadWithProgress(checksums.BrowserDownloadURL, checksumsPath); err != nil {
+			return fmt.Errorf("downloading checksums: %w", err)
+		}
+		fmt.Printf("  • Verifying checksum...\n")
+		if err := verifyChecksum(checksumsPath, asset.Name, archivePath); err != nil {
+			return fmt.Errorf("checksum verification failed: %w", err)
+		}
+	} else {
+		fmt.Printf("  %s No checksums.txt found in release; skipping verification\n", style.Warning.Render("⚠"))
+	}
+
+	fmt.Printf("  • Extracting binary...\n")
+	binaryPath, err := extractBinaryFromArchive(archivePath, tmpDir)
+	if err != nil {
+		return fmt.Errorf("extracting binary: %w", err)
+	}
+
+	targetDir := filepath.Dir(installPath)
+	if err := os.MkdirAll(targetDir, 0755); err != nil {
+		return fmt.Errorf("creating target directory: %w", err)
+	}
+
+	// Smoke test the downloaded binary before it ever touches the install
+	// path, same as a source build - catches a corrupt or mismatched-platform
+	// download that a checksum match alone wouldn't.
+	releaseInfo := &versionInfo{Version: release.TagName, Commit: "unknown", BuildTime: time.Now().UTC().Format(time.RFC3339)}
+	if !updateSkipSmoke {
+		fmt.Printf("  • Running smoke tests...\n")
+		// The release's commit is not known here, so the commit round-trip
+		// check is skipped by smoke testing with an empty commit.
+		smokeInfo := *releaseInfo
+		smokeInfo.Commit = ""
+		if err := runSmokeTests(binaryPath, &smokeInfo, updateSmokeCmd, updateSmokeTimeout); err != nil {
+			return fmt.Errorf("smoke tests failed, aborting install: %w", err)
+		}
+	}
+
+	// Preserve the release build in the version store like a source build
+	// would, so it's reachable via `gt update --list` / `--rollback`.
+	storedBinary, err := storeVersion(binaryPath, releaseInfo)
+	if err != nil {
+		return fmt.Errorf("storing version: %w", err)
+	}
+	if err := recordPrevious(installPath); err != nil {
+		return fmt.Errorf("recording previous version: %w", err)
+	}
+
+	fmt.Printf("  • Installing to %s...\n", installPath)
+	if err := copyFile(storedBinary, installPath); err != nil {
+		return fmt.Errorf("installing binary: %w", err)
+	}
+
+	if runtime.GOOS == "darwin" {
+		fmt.Printf("  • Codesigning for macOS...\n")
+		signCmd := exec.Command("codesign", "-s", "-", "-f", installPath)
+		_ = signCmd.Run()
+	}
+
+	// Record a checksum manifest alongside the installed binary, same as a
+	// source build, so `gt verify` doesn't report "no build manifest found"
+	// just because this install came from --from-release.
+	fmt.Printf("  • Writing checksum manifest...\n")
+	manifest, err := buildManifest(installPath, releaseInfo)
+	if err != nil {
+		return fmt.Errorf("building manifest: %w", err)
+	}
+	manifestFile, err := writeManifest(installPath, manifest)
+	if err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+
+	if updateSignKey != "" {
+		fmt.Printf("  • Signing manifest...\n")
+		if _, err := signManifest(manifestFile, updateSignKey); err != nil {
+			return fmt.Errorf("signing manifest: %w", err)
+		}
+	}
+
+	fmt.Printf("\n%s gt updated successfully from release %s!\n", style.Success.Render("✓"), release.TagName)
+	fmt.Printf("\nInstalled: %s\n", installPath)
+	return nil
+}
+
+const githubReleasesAPI = "https://api.github.com/repos/steveyegge/gastown/releases"
+
+// githubRelease is the subset of the GitHub Releases API response we need.
+type githubRelease struct {
+	TagName string        `json:"tag_name"`
+	Assets  []githubAsset `json:"assets"`
+}
+
+type githubAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+	Size               int64  `json:"size"`
+}
+
+// fetchRelease looks up a GitHub release: the latest one if version is empty,
+// or the release tagged exactly version (e.g. "v1.2.3") otherwise.
+func fetchRelease(version string) (*githubRelease, error) {
+	url := githubReleasesAPI + "/latest"
+	if version != "" {
+		url = githubReleasesAPI + "/tags/" + version
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("querying GitHub releases: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GitHub releases API returned %s", resp.Status)
+	}
+
+	var release githubRelease
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return nil, fmt.Errorf("decoding release: %w", err)
+	}
+	return &release, nil
+}
+
+// releaseAssetName mirrors the naming `gt release` produces.
+func releaseAssetName(version, goos, goarch string) string {
+	ext := "tar.gz"
+	if goos == "windows" {
+		ext = "zip"
+	}
+	return fmt.Sprintf("gt_%s_%s_%s.%s", version, goos, goarch, ext)
+}
+
+// findReleaseAsset picks the release asset matching the host GOOS/GOARCH.
+func findReleaseAsset(release *githubRelease) (*githubAsset, error) {
+	want := releaseAssetName(release.TagName, runtime.GOOS, runtime.GOARCH)
+	for i := range release.Assets {
+		if release.Assets[i].Name == want {
+			return &release.Assets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no release asset found for %s/%s (wanted %s)", runtime.GOOS, runtime.GOARCH, want)
+}
+
+// findChecksumsAsset locates the release's checksums.txt asset, if any.
+func findChecksumsAsset(release *githubRelease) *githubAsset {
+	for i := range release.Assets {
+		if release.Assets[i].Name == "checksums.txt" {
+			return &release.Assets[i]
+		}
+	}
+	return nil
+}
+
+// downloadWithProgress streams url to dest, printing periodic progress.
+func downloadWithProgress(url, dest string) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return fmt.Errorf("downloading %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading %s: %s", url, resp.Status)
+	}
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	pw := &progressWriter{total: resp.ContentLength}
+	_, err = io.Copy(out, io.TeeReader(resp.Body, pw))
+	pw.finish()
+	return err
+}
+
+// progressWriter prints download progress at most once per second.
+type progressWriter struct {
+	total     int64
+	written   int64
+	lastPrint time.Time
+}
+
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	pw.written += int64(len(p))
+	if time.Since(pw.lastPrint) > time.Second {
+		pw.print()
+		pw.lastPrint = time.Now()
+	}
+	return len(p), nil
+}
+
+func (pw *progressWriter) print() {
+	if pw.total > 0 {
+		fmt.Printf("\r    %s / %s (%.0f%%)  ", humanBytes(pw.written), humanBytes(pw.total), 100*float64(pw.written)/float64(pw.total))
+	} else {
+		fmt.Printf("\r    %s  ", humanBytes(pw.written))
+	}
+}
+
+func (pw *progressWriter) finish() {
+	pw.print()
+	fmt.Println()
+}
+
+func humanBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for n/div >= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// verifyChecksum confirms path's SHA256 matches the entry for name in a
+// checksums.txt file formatted as "<hex-sha256>  <filename>" per line.
+func verifyChecksum(checksumsFile, name, path string) error {
+	data, err := os.ReadFile(checksumsFile)
+	if err != nil {
+		return err
+	}
+
+	var want string
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == name {
+			want = fields[0]
+			break
+		}
+	}
+	if want == "" {
+		return fmt.Errorf("no checksum entry for %s", name)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	got := hex.EncodeToString(h.Sum(nil))
+	if got != want {
+		return fmt.Errorf("checksum mismatch for %s: got %s, want %s", name, got, want)
+	}
+	return nil
+}
+
+// extractBinaryFromArchive pulls the gt binary out of a release archive
+// (tar.gz on unix, zip on windows) into destDir.
+func extractBinaryFromArchive(archivePath, destDir string) (string, error) {
+	binaryName := "gt"
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+	destPath := filepath.Join(destDir, binaryName)
+
+	if strings.HasSuffix(archivePath, ".zip") {
+		return destPath, extractBinaryFromZip(archivePath, binaryName, destPath)
+	}
+	return destPath, extractBinaryFromTarGz(archivePath, binaryName, destPath)
+}
+
+func extractBinaryFromTarGz(archivePath, binaryName, destPath string) error {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gzr, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%s not found in archive", binaryName)
+		}
+		if err != nil {
+			return err
+		}
+		if filepath.Base(hdr.Name) != binaryName {
+			continue
+		}
+		out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, tr)
+		return err
+	}
+}
+
+func extractBinaryFromZip(archivePath, binaryName, destPath string) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		if filepath.Base(f.Name) != binaryName {
+			continue
+		}
+		src, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+
+		out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+		_, err = io.Copy(out, src)
+		return err
+	}
+	return fmt.Errorf("%s not found in archive", binaryName)
+}
@@ -0,0 +1,77 @@
+// ABOUTME: Post-build smoke tests that gate `gt update` installs on a freshly built binary actually running.
+// ABOUTME: Catches things like broken go:generate output before a bad gt ever reaches the user's PATH.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// runSmokeTests exercises a freshly built gt binary before it's installed:
+// --version, --help, and any configured `gt <cmd> --dry-run` invocations,
+// all run against a temp HOME so nothing touches the user's real config.
+// It fails closed - any non-zero exit, timeout, or ldflags mismatch aborts
+// the install.
+func runSmokeTests(tmpBinary string, info *versionInfo, extraCmds []string, timeout time.Duration) error {
+	tmpHome, err := os.MkdirTemp("", "gt-smoke-home-")
+	if err != nil {
+		return fmt.Errorf("creating smoke test HOME: %w", err)
+	}
+	defer os.RemoveAll(tmpHome)
+
+	if err := smokeRun(tmpBinary, tmpHome, timeout, "--version"); err != nil {
+		return err
+	}
+
+	versionOutput, err := smokeOutput(tmpBinary, tmpHome, timeout, "--version")
+	if err != nil {
+		return err
+	}
+	shortCommit := info.Commit
+	if len(shortCommit) > 7 {
+		shortCommit = shortCommit[:7]
+	}
+	if shortCommit != "" && !strings.Contains(versionOutput, shortCommit) {
+		return fmt.Errorf("--version output %q does not mention built commit %s (ldflags did not round-trip)", strings.TrimSpace(versionOutput), shortCommit)
+	}
+
+	if err := smokeRun(tmpBinary, tmpHome, timeout, "--help"); err != nil {
+		return err
+	}
+
+	for _, sub := range extraCmds {
+		args := append(strings.Fields(sub), "--dry-run")
+		if err := smokeRun(tmpBinary, tmpHome, timeout, args...); err != nil {
+			return fmt.Errorf("smoke command %q: %w", sub, err)
+		}
+	}
+
+	return nil
+}
+
+func smokeRun(binary, home string, timeout time.Duration, args ...string) error {
+	_, err := smokeOutput(binary, home, timeout, args...)
+	return err
+}
+
+func smokeOutput(binary, home string, timeout time.Duration, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, binary, args...)
+	cmd.Env = append(os.Environ(), "HOME="+home, "USERPROFILE="+home)
+
+	out, err := cmd.CombinedOutput()
+	if ctx.Err() == context.DeadlineExceeded {
+		return string(out), fmt.Errorf("%s %s timed out after %s", binary, strings.Join(args, " "), timeout)
+	}
+	if err != nil {
+		return string(out), fmt.Errorf("%s %s failed: %w\n%s", binary, strings.Join(args, " "), err, out)
+	}
+	return string(out), nil
+}
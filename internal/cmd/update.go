@@ -18,8 +18,18 @@ import (
 )
 
 var (
-	updateForce  bool
-	updateDryRun bool
+	updateForce        bool
+	updateDryRun       bool
+	updateSignKey      string
+	updateKeepSandbox  bool
+	updateReproducible bool
+	updateFromRelease  bool
+	updateVersion      string
+	updateRollback     bool
+	updateList         bool
+	updateSkipSmoke    bool
+	updateSmokeTimeout time.Duration
+	updateSmokeCmd     []string
 )
 
 var updateCmd = &cobra.Command{
@@ -34,24 +44,59 @@ to the same location as your current binary (or ~/.local/bin/gt by default).
 The rebuild includes version information (git tag, commit, build time) so
 staleness checks work correctly after update.
 
+If no gt source repository can be found, or --from-release is passed, gt
+instead downloads a prebuilt binary from GitHub Releases.
+
+Before installing, the freshly built binary is smoke tested (--version,
+--help, and any --smoke-cmd invocations) in a temp HOME; a failure aborts
+the install and discards the build.
+
 Examples:
   gt update               # Rebuild and install
   gt update --dry-run     # Show what would be done
-  gt update --force       # Skip confirmation prompts`,
+  gt update --force       # Skip confirmation prompts
+  gt update --from-release               # Install the latest GitHub release
+  gt update --from-release --version v1.2.3
+  gt update --list                       # Show installed versions
+  gt update --rollback                   # Swap back to the previous version
+  gt update --skip-smoke                 # Skip the post-build smoke tests`,
 	RunE: runUpdate,
 }
 
 func init() {
 	updateCmd.Flags().BoolVarP(&updateForce, "force", "f", false, "Skip confirmation prompts")
 	updateCmd.Flags().BoolVar(&updateDryRun, "dry-run", false, "Show what would be done without doing it")
+	updateCmd.Flags().StringVar(&updateSignKey, "sign-key", "", "Path to an ed25519 private key to sign the build manifest with")
+	updateCmd.Flags().BoolVar(&updateKeepSandbox, "keep-sandbox", false, "Keep the isolated build sandbox after the build for debugging")
+	updateCmd.Flags().BoolVar(&updateReproducible, "reproducible", false, "Pin SOURCE_DATE_EPOCH and the recorded build time for a reproducible build")
+	updateCmd.Flags().BoolVar(&updateFromRelease, "from-release", false, "Update by downloading a prebuilt binary from GitHub Releases instead of building from source")
+	updateCmd.Flags().StringVar(&updateVersion, "version", "", "Release version to install with --from-release, e.g. v1.2.3 (default: latest)")
+	updateCmd.Flags().BoolVar(&updateRollback, "rollback", false, "Swap back to the previously installed version")
+	updateCmd.Flags().BoolVar(&updateList, "list", false, "List installed versions from the version store")
+	updateCmd.Flags().BoolVar(&updateSkipSmoke, "skip-smoke", false, "Skip the post-build smoke tests")
+	updateCmd.Flags().DurationVar(&updateSmokeTimeout, "smoke-timeout", 10*time.Second, "Timeout for each smoke test invocation")
+	updateCmd.Flags().StringArrayVar(&updateSmokeCmd, "smoke-cmd", nil, "Additional \"gt <subcommand>\" to smoke test with --dry-run (repeatable)")
 	rootCmd.AddCommand(updateCmd)
 }
 
 func runUpdate(cmd *cobra.Command, args []string) error {
+	if updateList {
+		return runUpdateList()
+	}
+	if updateRollback {
+		return runUpdateRollback()
+	}
+
 	// Find the gt source repository
 	repoRoot, err := version.GetRepoRoot()
 	if err != nil {
-		return fmt.Errorf("cannot locate gt source repository: %w\n\nMake sure you have the gastown repository cloned and set GT_ROOT if needed", err)
+		// No source tree to build from - fall back to installing a
+		// prebuilt binary from GitHub Releases instead of failing outright.
+		fmt.Printf("%s No gt source repository found (%s); falling back to --from-release\n\n", style.Dim.Render("ℹ"), err)
+		return runUpdateFromRelease()
+	}
+	if updateFromRelease {
+		return runUpdateFromRelease()
 	}
 
 	fmt.Printf("%s Found gt repository at %s\n\n", style.Bold.Render("🔍"), style.Dim.Render(repoRoot))
@@ -96,9 +141,12 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		fmt.Println("\nWould execute:")
 		fmt.Printf("  1. Run go generate in %s\n", repoRoot)
 		fmt.Printf("  2. Build binary with version info\n")
-		fmt.Printf("  3. Install to %s\n", installPath)
+		if !updateSkipSmoke {
+			fmt.Printf("  3. Smoke test the built binary\n")
+		}
+		fmt.Printf("  4. Install to %s\n", installPath)
 		if runtime.GOOS == "darwin" {
-			fmt.Printf("  4. Codesign binary for macOS\n")
+			fmt.Printf("  5. Codesign binary for macOS\n")
 		}
 		return nil
 	}
@@ -123,11 +171,34 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("getting version info: %w", err)
 	}
+	var sourceEpoch int64
+	if updateReproducible {
+		sourceEpoch, err = sourceDateEpoch(repoRoot)
+		if err != nil {
+			return fmt.Errorf("determining reproducible build time: %w", err)
+		}
+		versionInfo.BuildTime = time.Unix(sourceEpoch, 0).UTC().Format(time.RFC3339)
+	}
+
+	// Materialize an isolated sandbox containing only tracked source files,
+	// so the build can't pick up local replace directives, dirty workspace
+	// state, or env drift from repoRoot.
+	fmt.Printf("  • Preparing isolated build sandbox...\n")
+	sandbox, err := newBuildSandbox(repoRoot)
+	if err != nil {
+		return fmt.Errorf("preparing build sandbox: %w", err)
+	}
+	if updateKeepSandbox {
+		fmt.Printf("  • Keeping sandbox at %s\n", sandbox.Dir)
+	} else {
+		defer sandbox.Close()
+	}
 
 	// Run go generate
 	fmt.Printf("  • Running go generate...\n")
 	genCmd := exec.Command("go", "generate", "./...")
-	genCmd.Dir = repoRoot
+	genCmd.Dir = sandbox.Dir
+	genCmd.Env = sandbox.Env(updateReproducible, sourceEpoch)
 	genCmd.Stderr = os.Stderr
 	if err := genCmd.Run(); err != nil {
 		return fmt.Errorf("go generate failed: %w", err)
@@ -142,7 +213,8 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		versionInfo.Version, versionInfo.Commit, versionInfo.BuildTime)
 
 	buildCmd := exec.Command("go", "build", "-ldflags", ldflags, "-o", tmpBinary, "./cmd/gt")
-	buildCmd.Dir = repoRoot
+	buildCmd.Dir = sandbox.Dir
+	buildCmd.Env = sandbox.Env(updateReproducible, sourceEpoch)
 	buildCmd.Stderr = os.Stderr
 	if err := buildCmd.Run(); err != nil {
 		return fmt.Errorf("go build failed: %w", err)
@@ -153,6 +225,16 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("build succeeded but binary not found at %s: %w", tmpBinary, err)
 	}
 
+	// Smoke test the freshly built binary before it ever touches the
+	// install path - catches things like a go:generate step that silently
+	// produced invalid embedded assets.
+	if !updateSkipSmoke {
+		fmt.Printf("  • Running smoke tests...\n")
+		if err := runSmokeTests(tmpBinary, versionInfo, updateSmokeCmd, updateSmokeTimeout); err != nil {
+			return fmt.Errorf("smoke tests failed, aborting install: %w", err)
+		}
+	}
+
 	// Install to target location
 	fmt.Printf("  • Installing to %s...\n", installPath)
 
@@ -162,13 +244,27 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("creating target directory: %w", err)
 	}
 
-	// Copy the binary (handles overwriting current binary)
-	// copyFile handles permissions and atomic replacement
-	if err := copyFile(tmpBinary, installPath); err != nil {
+	// Preserve the binary in the multi-version store and remember what was
+	// previously installed, so `gt update --rollback` can swap back to it.
+	fmt.Printf("  • Storing version %s...\n", versionInfo.Version)
+	storedBinary, err := storeVersion(tmpBinary, versionInfo)
+	if err != nil {
+		return fmt.Errorf("storing version: %w", err)
+	}
+	if err := recordPrevious(installPath); err != nil {
+		return fmt.Errorf("recording previous version: %w", err)
+	}
+
+	// Install to target location (copyFile points installPath at the stored binary)
+	if err := copyFile(storedBinary, installPath); err != nil {
 		return fmt.Errorf("installing binary: %w", err)
 	}
 
-	// On macOS, codesign the binary
+	// On macOS, codesign the binary. This must happen before the checksum
+	// manifest is computed below: codesign follows the install symlink and
+	// rewrites the stored binary's bytes in place, so hashing first would
+	// record the pre-signed checksum and every "gt verify" would report a
+	// mismatch.
 	if runtime.GOOS == "darwin" {
 		fmt.Printf("  • Codesigning for macOS...\n")
 		signCmd := exec.Command("codesign", "-s", "-", "-f", installPath)
@@ -176,6 +272,25 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		_ = signCmd.Run()
 	}
 
+	// Record a checksum manifest alongside the installed binary so `gt verify`
+	// can detect tampering or corruption.
+	fmt.Printf("  • Writing checksum manifest...\n")
+	manifest, err := buildManifest(installPath, versionInfo)
+	if err != nil {
+		return fmt.Errorf("building manifest: %w", err)
+	}
+	manifestFile, err := writeManifest(installPath, manifest)
+	if err != nil {
+		return fmt.Errorf("writing manifest: %w", err)
+	}
+
+	if updateSignKey != "" {
+		fmt.Printf("  • Signing manifest...\n")
+		if _, err := signManifest(manifestFile, updateSignKey); err != nil {
+			return fmt.Errorf("signing manifest: %w", err)
+		}
+	}
+
 	fmt.Printf("\n%s gt updated successfully!\n", style.Success.Render("✓"))
 	fmt.Printf("\nInstalled: %s\n", installPath)
 	fmt.Printf("Version:   %s\n", versionInfo.Version)
@@ -223,51 +338,109 @@ func getVersionInfo(repoDir string) (*versionInfo, error) {
 // Priority:
 // 1. Location of current running binary
 // 2. ~/.local/bin/gt (default)
+//
+// The returned path must be the stable, user-facing install location - never
+// a path inside the version store (see versionstore.go). Once an install
+// symlinks that location into the store, os.Executable() resolves straight
+// through to the store entry; installing "to" a store path would symlink a
+// stored version onto itself or onto a sibling version and corrupt the
+// store. So a running binary that resolves into the store is never used
+// directly: if ~/.local/bin/gt points at it, that path is returned; no other
+// symlink location is searched, and otherwise the default is returned.
 func determineInstallLocation() (string, error) {
-	// Try to find current binary location
-	currentBinary, err := os.Executable()
-	if err == nil {
-		// Resolve symlinks
-		if resolved, err := filepath.EvalSymlinks(currentBinary); err == nil {
-			currentBinary = resolved
+	home, homeErr := os.UserHomeDir()
+	var defaultPath string
+	if homeErr == nil {
+		defaultPath = filepath.Join(home, ".local", "bin", "gt")
+	}
+
+	if currentBinary, err := os.Executable(); err == nil {
+		resolved, err := filepath.EvalSymlinks(currentBinary)
+		if err != nil {
+			resolved = currentBinary
 		}
 
-		// Check if it's in a reasonable location (not in /tmp or go build cache)
-		if !strings.Contains(currentBinary, "/tmp") && !strings.Contains(currentBinary, "go-build") {
+		if _, ok := versionStoreRel(resolved); ok {
+			if defaultPath != "" {
+				if target, err := filepath.EvalSymlinks(defaultPath); err == nil && target == resolved {
+					return defaultPath, nil
+				}
+			}
+			// Resolves into the store but doesn't match the default
+			// location's symlink; fall through to the default below rather
+			// than returning the store path itself.
+		} else if !strings.Contains(resolved, "/tmp") && !strings.Contains(resolved, "go-build") {
 			return currentBinary, nil
 		}
 	}
 
-	// Default to ~/.local/bin/gt
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", fmt.Errorf("getting home directory: %w", err)
+	if defaultPath == "" {
+		return "", fmt.Errorf("getting home directory: %w", homeErr)
 	}
-
-	return filepath.Join(home, ".local", "bin", "gt"), nil
+	return defaultPath, nil
 }
 
-// copyFile copies a file from src to dst, handling the case where dst might be
-// the currently running binary (which causes "text file busy" errors).
-// The workaround is to write to a temp file and then atomically rename it.
+// copyFile installs src as dst. dst is always made (or kept) a symlink
+// pointing at src rather than a byte-for-byte copy: src is expected to live
+// in the permanent version store (see versionstore.go), so repointing the
+// symlink is enough to install it, and an atomic symlink swap sidesteps
+// "text file busy" more cleanly than overwriting a running executable ever
+// could. Windows lacks unprivileged symlinks, so there we fall back to a
+// real copy plus a ".current" indirection file recording the store path.
 func copyFile(src, dst string) error {
-	// Read source file
+	if runtime.GOOS == "windows" {
+		return copyFileWindows(src, dst)
+	}
+
+	tmpDst := dst + ".new"
+	os.Remove(tmpDst) // clear any stale symlink left by a prior failed attempt
+	if err := os.Symlink(src, tmpDst); err != nil {
+		return fmt.Errorf("creating symlink: %w", err)
+	}
+
+	// Atomically rename (this works even if dst is a running executable)
+	if err := os.Rename(tmpDst, dst); err != nil {
+		os.Remove(tmpDst) // Clean up temp file
+		return fmt.Errorf("renaming symlink into place: %w", err)
+	}
+
+	return nil
+}
+
+// copyFileWindows installs src as dst by copying its bytes (Windows can't
+// symlink without elevated privileges) and records src's path in a
+// ".current" sidecar file so the version store can tell what's installed.
+func copyFileWindows(src, dst string) error {
 	data, err := os.ReadFile(src)
 	if err != nil {
 		return fmt.Errorf("reading source: %w", err)
 	}
 
-	// Write to temp file in same directory (for atomic rename)
 	tmpDst := dst + ".new"
 	if err := os.WriteFile(tmpDst, data, 0755); err != nil {
 		return fmt.Errorf("writing temp file: %w", err)
 	}
-
-	// Atomically rename (this works even if dst is a running executable)
 	if err := os.Rename(tmpDst, dst); err != nil {
-		os.Remove(tmpDst) // Clean up temp file
+		os.Remove(tmpDst)
 		return fmt.Errorf("renaming temp file: %w", err)
 	}
 
+	if err := os.WriteFile(dst+".current", []byte(src), 0644); err != nil {
+		return fmt.Errorf("writing current-version pointer: %w", err)
+	}
 	return nil
 }
+
+// resolveInstallTarget returns the path installPath effectively runs: the
+// symlink target on unix, the recorded store path from the ".current"
+// sidecar on Windows.
+func resolveInstallTarget(installPath string) (string, error) {
+	if runtime.GOOS == "windows" {
+		data, err := os.ReadFile(installPath + ".current")
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return filepath.EvalSymlinks(installPath)
+}
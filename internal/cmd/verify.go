@@ -0,0 +1,91 @@
+// ABOUTME: Command to check the installed gt binary against its recorded build manifest.
+// ABOUTME: Warns (or refuses to proceed) when the binary's checksum doesn't match what was installed.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/version"
+)
+
+var verifyStrict bool
+
+var verifyCmd = &cobra.Command{
+	Use:     "verify",
+	GroupID: GroupConfig,
+	Short:   "Verify the installed gt binary against its build manifest",
+	Long: `Verify checks the currently running gt binary's SHA256 checksum against
+the gt.sha256 manifest that a prior "gt update" wrote beside the install
+location.
+
+If GT_TRUSTED_PUBKEY is set, also verifies the manifest's detached ed25519
+signature (written by "gt update --sign-key") before trusting its checksum.
+
+Examples:
+  gt verify
+  gt verify --strict    # exit non-zero on any mismatch or missing manifest`,
+	RunE: runVerify,
+}
+
+func init() {
+	verifyCmd.Flags().BoolVar(&verifyStrict, "strict", false, "Exit non-zero if the manifest is missing or invalid")
+	rootCmd.AddCommand(verifyCmd)
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	// The manifest lives next to the stable install location, not
+	// necessarily next to the binary that's actually running: a symlink
+	// install resolves os.Executable() straight into the version store,
+	// which has no manifest of its own. determineInstallLocation() walks
+	// back to the install symlink gt update wrote the manifest beside.
+	installPath, err := determineInstallLocation()
+	if err != nil {
+		return fmt.Errorf("determining install location: %w", err)
+	}
+
+	runningBinary, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locating running binary: %w", err)
+	}
+
+	manifest, err := loadManifest(installPath)
+	if err != nil {
+		if verifyStrict {
+			return fmt.Errorf("no build manifest found: %w", err)
+		}
+		fmt.Printf("%s No build manifest found for %s\n", style.Warning.Render("⚠"), installPath)
+		return nil
+	}
+
+	if pubKey := os.Getenv("GT_TRUSTED_PUBKEY"); pubKey != "" {
+		if err := verifyManifestSignature(manifestPath(installPath), pubKey); err != nil {
+			if verifyStrict {
+				return fmt.Errorf("manifest signature invalid: %w", err)
+			}
+			fmt.Printf("%s Manifest signature invalid: %s\n", style.Warning.Render("⚠"), err)
+		} else {
+			fmt.Printf("%s Manifest signature verified\n", style.Success.Render("✓"))
+		}
+	}
+
+	actual, err := sha256File(runningBinary)
+	if err != nil {
+		return fmt.Errorf("hashing running binary: %w", err)
+	}
+
+	if actual != manifest.SHA256 {
+		msg := fmt.Sprintf("Binary checksum mismatch: running %s, manifest says %s", actual, manifest.SHA256)
+		if verifyStrict {
+			return fmt.Errorf("%s", msg)
+		}
+		fmt.Printf("%s %s\n", style.Warning.Render("⚠"), msg)
+		return nil
+	}
+
+	fmt.Printf("%s Binary matches build manifest (%s, commit %s)\n", style.Success.Render("✓"), manifest.Version, version.ShortCommit(manifest.Commit))
+	return nil
+}
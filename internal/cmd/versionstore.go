@@ -0,0 +1,249 @@
+// ABOUTME: Multi-version binary store backing `gt update --rollback` and `gt update --list`.
+// ABOUTME: Every install is preserved under ~/.local/share/gt/versions so prior builds stay reachable.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/version"
+)
+
+// runUpdateList implements `gt update --list`.
+func runUpdateList() error {
+	installPath, err := determineInstallLocation()
+	if err != nil {
+		return fmt.Errorf("determining install location: %w", err)
+	}
+
+	versions, err := listInstalledVersions(installPath)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		fmt.Println("No versions found in the store.")
+		return nil
+	}
+
+	for _, v := range versions {
+		marker := " "
+		if v.Current {
+			marker = style.Success.Render("*")
+		}
+		fmt.Printf("%s %-20s commit %s  built %s\n", marker, v.Info.Version, version.ShortCommit(v.Info.Commit), v.Info.BuildTime)
+	}
+	return nil
+}
+
+// runUpdateRollback implements `gt update --rollback`.
+func runUpdateRollback() error {
+	installPath, err := determineInstallLocation()
+	if err != nil {
+		return fmt.Errorf("determining install location: %w", err)
+	}
+
+	prevDir, err := rollbackToPrevious(installPath)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Rolled back to %s\n", style.Success.Render("✓"), prevDir)
+	return nil
+}
+
+// storedVersionInfo is the metadata recorded alongside each stored binary.
+type storedVersionInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+}
+
+// versionStoreDir returns ~/.local/share/gt/versions, creating it if needed.
+func versionStoreDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("getting home directory: %w", err)
+	}
+	dir := filepath.Join(home, ".local", "share", "gt", "versions")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating version store: %w", err)
+	}
+	return dir, nil
+}
+
+// versionDirName is the store subdirectory name for a build, e.g. "v1.2.3-abc1234".
+func versionDirName(info *versionInfo) string {
+	return fmt.Sprintf("%s-%s", info.Version, version.ShortCommit(info.Commit))
+}
+
+func previousPointerPath(storeDir string) string {
+	return filepath.Join(storeDir, "PREVIOUS")
+}
+
+// storeVersion copies binaryPath into the version store under a directory
+// named for its version and commit, alongside a metadata file, and returns
+// the path to the stored binary.
+func storeVersion(binaryPath string, info *versionInfo) (string, error) {
+	storeDir, err := versionStoreDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(storeDir, versionDirName(info))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("creating version directory: %w", err)
+	}
+
+	data, err := os.ReadFile(binaryPath)
+	if err != nil {
+		return "", fmt.Errorf("reading built binary: %w", err)
+	}
+
+	storedBinary := filepath.Join(dir, storedBinaryName())
+	if err := os.WriteFile(storedBinary, data, 0755); err != nil {
+		return "", fmt.Errorf("writing stored binary: %w", err)
+	}
+
+	meta := storedVersionInfo{Version: info.Version, Commit: info.Commit, BuildTime: info.BuildTime}
+	metaData, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling version metadata: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "info.json"), metaData, 0644); err != nil {
+		return "", fmt.Errorf("writing version metadata: %w", err)
+	}
+
+	return storedBinary, nil
+}
+
+func storedBinaryName() string {
+	if runtime.GOOS == "windows" {
+		return "gt.exe"
+	}
+	return "gt"
+}
+
+// recordPrevious remembers the version currently installed at installPath,
+// before it's replaced, so `gt update --rollback` can swap back to it.
+func recordPrevious(installPath string) error {
+	storeDir, err := versionStoreDir()
+	if err != nil {
+		return err
+	}
+
+	current, ok := currentStoredVersionDir(installPath)
+	if !ok {
+		return nil // nothing installed yet, or not managed by the version store
+	}
+	return os.WriteFile(previousPointerPath(storeDir), []byte(current), 0644)
+}
+
+// currentStoredVersionDir reports the version-store directory name that
+// installPath currently resolves to, if any.
+func currentStoredVersionDir(installPath string) (string, bool) {
+	target, err := resolveInstallTarget(installPath)
+	if err != nil {
+		return "", false
+	}
+
+	rel, ok := versionStoreRel(target)
+	if !ok {
+		return "", false
+	}
+	return strings.Split(rel, string(filepath.Separator))[0], true
+}
+
+// versionStoreRel reports path's location relative to the version store
+// root, if path lives there at all.
+func versionStoreRel(path string) (string, bool) {
+	storeDir, err := versionStoreDir()
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(storeDir, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	return rel, true
+}
+
+// installedVersion describes one entry in the version store, for `gt update --list`.
+type installedVersion struct {
+	Dir     string
+	Info    storedVersionInfo
+	Current bool
+}
+
+// listInstalledVersions returns every stored version, newest first, marking
+// whichever one installPath currently resolves to.
+func listInstalledVersions(installPath string) ([]installedVersion, error) {
+	storeDir, err := versionStoreDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(storeDir)
+	if err != nil {
+		return nil, fmt.Errorf("reading version store: %w", err)
+	}
+
+	current, _ := currentStoredVersionDir(installPath)
+
+	var versions []installedVersion
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		metaData, err := os.ReadFile(filepath.Join(storeDir, e.Name(), "info.json"))
+		if err != nil {
+			continue
+		}
+		var meta storedVersionInfo
+		if err := json.Unmarshal(metaData, &meta); err != nil {
+			continue
+		}
+		versions = append(versions, installedVersion{
+			Dir:     e.Name(),
+			Info:    meta,
+			Current: e.Name() == current,
+		})
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Info.BuildTime > versions[j].Info.BuildTime })
+	return versions, nil
+}
+
+// rollbackToPrevious swaps installPath back to whatever version was active
+// before the last install.
+func rollbackToPrevious(installPath string) (string, error) {
+	storeDir, err := versionStoreDir()
+	if err != nil {
+		return "", err
+	}
+
+	prevData, err := os.ReadFile(previousPointerPath(storeDir))
+	if err != nil {
+		return "", fmt.Errorf("no previous version recorded: %w", err)
+	}
+	prevDir := strings.TrimSpace(string(prevData))
+
+	storedBinary := filepath.Join(storeDir, prevDir, storedBinaryName())
+	if _, err := os.Stat(storedBinary); err != nil {
+		return "", fmt.Errorf("previous version %s is no longer in the store: %w", prevDir, err)
+	}
+
+	if err := recordPrevious(installPath); err != nil {
+		return "", fmt.Errorf("recording current version before rollback: %w", err)
+	}
+	if err := copyFile(storedBinary, installPath); err != nil {
+		return "", fmt.Errorf("installing previous version: %w", err)
+	}
+	return prevDir, nil
+}
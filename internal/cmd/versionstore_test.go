@@ -0,0 +1,95 @@
+// ABOUTME: Tests for the version store's recordPrevious/rollbackToPrevious toggle behavior.
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// installVersion stores and installs a version as if `gt update` had just
+// run, returning the version's store directory name.
+func installVersion(t *testing.T, installPath string, info *versionInfo) string {
+	t.Helper()
+
+	binary := filepath.Join(t.TempDir(), "gt-built")
+	if err := os.WriteFile(binary, []byte("binary contents for "+info.Version), 0755); err != nil {
+		t.Fatalf("writing built binary: %v", err)
+	}
+
+	storedBinary, err := storeVersion(binary, info)
+	if err != nil {
+		t.Fatalf("storeVersion: %v", err)
+	}
+	if err := recordPrevious(installPath); err != nil {
+		t.Fatalf("recordPrevious: %v", err)
+	}
+	if err := copyFile(storedBinary, installPath); err != nil {
+		t.Fatalf("copyFile: %v", err)
+	}
+	return versionDirName(info)
+}
+
+func TestRollbackToPreviousTogglesBetweenTwoVersions(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	installDir := t.TempDir()
+	installPath := filepath.Join(installDir, "gt")
+
+	v1 := &versionInfo{Version: "v1.0.0", Commit: "aaaaaaaaaaaa", BuildTime: "2026-01-01T00:00:00Z"}
+	v2 := &versionInfo{Version: "v2.0.0", Commit: "bbbbbbbbbbbb", BuildTime: "2026-02-01T00:00:00Z"}
+
+	v1Dir := installVersion(t, installPath, v1)
+	v2Dir := installVersion(t, installPath, v2)
+
+	current, ok := currentStoredVersionDir(installPath)
+	if !ok || current != v2Dir {
+		t.Fatalf("currentStoredVersionDir = %q, %v; want %q, true", current, ok, v2Dir)
+	}
+
+	// Rolling back once should return to v1.
+	prevDir, err := rollbackToPrevious(installPath)
+	if err != nil {
+		t.Fatalf("rollbackToPrevious: %v", err)
+	}
+	if prevDir != v1Dir {
+		t.Fatalf("rollbackToPrevious returned %q, want %q", prevDir, v1Dir)
+	}
+	if current, ok := currentStoredVersionDir(installPath); !ok || current != v1Dir {
+		t.Fatalf("after rollback, currentStoredVersionDir = %q, %v; want %q, true", current, ok, v1Dir)
+	}
+
+	// Rolling back again should toggle back to v2, since rollbackToPrevious
+	// itself records the pre-rollback version before swapping.
+	prevDir, err = rollbackToPrevious(installPath)
+	if err != nil {
+		t.Fatalf("second rollbackToPrevious: %v", err)
+	}
+	if prevDir != v2Dir {
+		t.Fatalf("second rollbackToPrevious returned %q, want %q", prevDir, v2Dir)
+	}
+	if current, ok := currentStoredVersionDir(installPath); !ok || current != v2Dir {
+		t.Fatalf("after second rollback, currentStoredVersionDir = %q, %v; want %q, true", current, ok, v2Dir)
+	}
+}
+
+func TestRollbackToPreviousWithNoPriorInstallFails(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	installPath := filepath.Join(t.TempDir(), "gt")
+
+	if _, err := rollbackToPrevious(installPath); err == nil {
+		t.Fatal("rollbackToPrevious returned nil with no previous version recorded")
+	}
+}
+
+func TestRecordPreviousNoopsWhenNothingInstalled(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	installPath := filepath.Join(t.TempDir(), "gt")
+
+	if err := recordPrevious(installPath); err != nil {
+		t.Fatalf("recordPrevious on an empty install path returned an error: %v", err)
+	}
+	if _, ok := currentStoredVersionDir(installPath); ok {
+		t.Fatal("currentStoredVersionDir reported a version when nothing was installed")
+	}
+}